@@ -0,0 +1,39 @@
+// Package catch implements pluggable models for whether a thrown Pokeball
+// catches a Pokemon.
+package catch
+
+import (
+	"math/rand/v2"
+
+	"github.com/ebrahim5801/pokedex/internal/pokeapi"
+)
+
+// Strategy computes the probability, in [0, 1], that pokemon is caught.
+type Strategy interface {
+	CatchChance(pokemon pokeapi.Pokemon) float64
+}
+
+// CatchCalculator decides catch outcomes using a Strategy.
+type CatchCalculator struct {
+	strategy Strategy
+}
+
+// NewCatchCalculator returns a CatchCalculator backed by strategy.
+func NewCatchCalculator(strategy Strategy) *CatchCalculator {
+	return &CatchCalculator{strategy: strategy}
+}
+
+// Attempt rolls against the strategy's catch chance for pokemon, returning
+// whether it was caught and the chance that was rolled against.
+func (c *CatchCalculator) Attempt(pokemon pokeapi.Pokemon) (caught bool, chance float64) {
+	chance = c.strategy.CatchChance(pokemon)
+	return rand.Float64() < chance, chance
+}
+
+func statTotal(pokemon pokeapi.Pokemon) int {
+	total := 0
+	for _, s := range pokemon.Stats {
+		total += s.BaseStat
+	}
+	return total
+}
@@ -0,0 +1,88 @@
+package catch
+
+import (
+	"testing"
+
+	"github.com/ebrahim5801/pokedex/internal/pokeapi"
+)
+
+func TestLinearStrategyCatchChance(t *testing.T) {
+	tests := []struct {
+		name       string
+		experience int
+		want       float64
+	}{
+		{"no experience", 0, 1},
+		{"max experience caps at minChance", 10000, linearMinChance},
+		{"mid experience", 150, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pokemon := pokeapi.Pokemon{Experience: tt.experience}
+			got := LinearStrategy{}.CatchChance(pokemon)
+			if got != tt.want {
+				t.Errorf("CatchChance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerationOneStrategyCatchChance(t *testing.T) {
+	weak := pokeapi.Pokemon{Stats: []pokeapi.PokemonStat{{BaseStat: 10, Stat: pokeapi.StatName{Name: "hp"}}}}
+	legendary := pokeapi.Pokemon{Stats: []pokeapi.PokemonStat{{BaseStat: 130, Stat: pokeapi.StatName{Name: "hp"}}, {BaseStat: 150, Stat: pokeapi.StatName{Name: "attack"}}, {BaseStat: 100, Stat: pokeapi.StatName{Name: "defense"}}, {BaseStat: 120, Stat: pokeapi.StatName{Name: "special-attack"}}, {BaseStat: 100, Stat: pokeapi.StatName{Name: "special-defense"}}, {BaseStat: 100, Stat: pokeapi.StatName{Name: "speed"}}}}
+
+	weakChance := GenerationOneStrategy{}.CatchChance(weak)
+	legendaryChance := GenerationOneStrategy{}.CatchChance(legendary)
+
+	for _, chance := range []float64{weakChance, legendaryChance} {
+		if chance < 0 || chance > 1 {
+			t.Fatalf("CatchChance() = %v, want a value in [0, 1]", chance)
+		}
+	}
+
+	// Even the toughest Pokemon should stay within a believable range —
+	// a regression that re-compounds the shake check would push this well
+	// under 1%.
+	const minBelievableChance = 0.01
+	if legendaryChance < minBelievableChance {
+		t.Errorf("legendary CatchChance() = %v, want >= %v", legendaryChance, minBelievableChance)
+	}
+
+	if weakChance <= legendaryChance {
+		t.Errorf("weak CatchChance() = %v, want > legendary CatchChance() = %v", weakChance, legendaryChance)
+	}
+}
+
+func TestDebugAlwaysCatchAlwaysCatches(t *testing.T) {
+	calc := NewCatchCalculator(DebugAlwaysCatch{})
+	for i := 0; i < 50; i++ {
+		caught, chance := calc.Attempt(pokeapi.Pokemon{Experience: 9999})
+		if !caught {
+			t.Fatalf("Attempt() caught = false, want true")
+		}
+		if chance != 1 {
+			t.Fatalf("Attempt() chance = %v, want 1", chance)
+		}
+	}
+}
+
+func TestParseStrategy(t *testing.T) {
+	tests := []struct {
+		model   string
+		wantErr bool
+	}{
+		{"", false},
+		{"linear", false},
+		{"gen1", false},
+		{"debug", false},
+		{"nonsense", true},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseStrategy(tt.model)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStrategy(%q) error = %v, wantErr %v", tt.model, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package catch
+
+import "fmt"
+
+// ParseStrategy resolves the --catch-model flag value to a Strategy. An
+// empty name selects LinearStrategy.
+func ParseStrategy(model string) (Strategy, error) {
+	switch model {
+	case "", "linear":
+		return LinearStrategy{}, nil
+	case "gen1":
+		return GenerationOneStrategy{}, nil
+	case "debug":
+		return DebugAlwaysCatch{}, nil
+	default:
+		return nil, fmt.Errorf("unknown catch model %q (want linear, gen1, or debug)", model)
+	}
+}
@@ -0,0 +1,64 @@
+package catch
+
+import "github.com/ebrahim5801/pokedex/internal/pokeapi"
+
+// LinearStrategy scales catch chance down linearly with base experience,
+// capping at minChance so nothing is ever truly uncatchable. This replaces
+// the old rand.Intn(100) > pokemon.Experience check, which made any Pokemon
+// with base_experience >= 100 impossible to catch.
+type LinearStrategy struct{}
+
+const (
+	linearMaxExperience = 300.0
+	linearMinChance     = 0.05
+)
+
+// CatchChance implements Strategy.
+func (LinearStrategy) CatchChance(pokemon pokeapi.Pokemon) float64 {
+	chance := 1 - float64(pokemon.Experience)/linearMaxExperience
+	if chance < linearMinChance {
+		chance = linearMinChance
+	}
+	if chance > 1 {
+		chance = 1
+	}
+	return chance
+}
+
+// GenerationOneStrategy approximates the original Generation I catch
+// formula. The real formula factors in the target's current HP, its
+// species catch rate, and the ball/status bonuses in play; we only have
+// base stats to work with, so we assume full HP, a standard Pokeball, and no
+// status condition, and derive a catch-rate stand-in from the Pokemon's
+// total base stats (tougher Pokemon are harder to catch).
+type GenerationOneStrategy struct{}
+
+// CatchChance implements Strategy.
+func (GenerationOneStrategy) CatchChance(pokemon pokeapi.Pokemon) float64 {
+	const (
+		ballBonus     = 1.0
+		statusBonus   = 1.0
+		maxCatchValue = 255.0
+	)
+
+	catchRate := maxCatchValue - float64(statTotal(pokemon))/3
+	if catchRate < 3 {
+		catchRate = 3
+	}
+
+	// At full HP, the classic (3*maxHP - 2*curHP) term collapses to maxHP,
+	// leaving catchValue = (catchRate * ballBonus * statusBonus) / 3. That
+	// catchValue/maxCatchValue ratio is already the overall catch
+	// probability (the four in-game shake checks each use this same value,
+	// not four independent sub-probabilities), so it's returned as-is.
+	catchValue := (catchRate * ballBonus * statusBonus) / 3
+	return catchValue / maxCatchValue
+}
+
+// DebugAlwaysCatch always succeeds, for use in tests and demos.
+type DebugAlwaysCatch struct{}
+
+// CatchChance implements Strategy.
+func (DebugAlwaysCatch) CatchChance(pokemon pokeapi.Pokemon) float64 {
+	return 1
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/ebrahim5801/pokedex/internal/catch"
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// CatchFunc returns the "catch" command. It refuses to catch a Pokemon that
+// hasn't been seen in the trainer's current location area.
+func CatchFunc(client pokeAPIClient, trainer *poketrainer.Trainer, calculator *catch.CatchCalculator) CommandFunc {
+	return func(args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("usage: catch <pokemon-name>")
+		}
+		name := args[0]
+
+		area := trainer.CurrentArea()
+		if area == "" {
+			return fmt.Errorf("visit a location area first")
+		}
+
+		pokemon, err := client.GetPokemon(name)
+		if err != nil {
+			return err
+		}
+
+		encounters, err := client.GetPokemonLocationAreas(pokemon.LocationAreaEncounters)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, e := range encounters {
+			if e.LocationArea.Name == area {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s has not been seen in %s", name, area)
+		}
+
+		fmt.Printf("Throwing a Pokeball at %s...\n", name)
+
+		caught, chance := calculator.Attempt(pokemon)
+		fmt.Printf("(catch chance: %.0f%%)\n", chance*100)
+
+		if caught {
+			fmt.Printf("%s was caught!\n", name)
+			trainer.Catch(name, pokemon)
+			if err := trainer.Save(); err != nil {
+				return fmt.Errorf("caught %s but failed to save pokedex: %w", name, err)
+			}
+		} else {
+			fmt.Printf("%s escaped!\n", name)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+// Package commands holds the CLI command callbacks for the Pokedex REPL.
+// Each command is built by a factory that closes over the dependencies it
+// needs, rather than reading them off a shared config struct.
+package commands
+
+import "github.com/ebrahim5801/pokedex/internal/pokeapi"
+
+// CommandFunc is a REPL command callback. args holds whatever the user typed
+// after the command name.
+type CommandFunc = func(args []string) error
+
+// pokeAPIClient is the slice of *pokeclient.Client that command factories
+// need. Depending on the interface instead of the concrete type lets tests
+// supply a fake.
+type pokeAPIClient interface {
+	GetLocationAreaList(url string) (pokeapi.NamedAPIResourceList, error)
+	GetLocationArea(name string) (pokeapi.LocationArea, error)
+	GetPokemon(name string) (pokeapi.Pokemon, error)
+	GetPokemonLocationAreas(url string) ([]pokeapi.LocationAreaEncounter, error)
+}
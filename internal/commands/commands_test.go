@@ -0,0 +1,293 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ebrahim5801/pokedex/internal/catch"
+	"github.com/ebrahim5801/pokedex/internal/pokeapi"
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// fakeClient is a pokeAPIClient stand-in so command tests don't hit the
+// network.
+type fakeClient struct {
+	locationAreaPages   map[string]pokeapi.NamedAPIResourceList
+	locationAreaListErr error
+	locationArea        pokeapi.LocationArea
+	locationAreaErr     error
+	pokemon             pokeapi.Pokemon
+	pokemonErr          error
+	encounters          []pokeapi.LocationAreaEncounter
+	encountersErr       error
+}
+
+func (f *fakeClient) GetLocationAreaList(url string) (pokeapi.NamedAPIResourceList, error) {
+	if f.locationAreaListErr != nil {
+		return pokeapi.NamedAPIResourceList{}, f.locationAreaListErr
+	}
+	return f.locationAreaPages[url], nil
+}
+
+func (f *fakeClient) GetLocationArea(name string) (pokeapi.LocationArea, error) {
+	return f.locationArea, f.locationAreaErr
+}
+
+func (f *fakeClient) GetPokemon(name string) (pokeapi.Pokemon, error) {
+	return f.pokemon, f.pokemonErr
+}
+
+func (f *fakeClient) GetPokemonLocationAreas(url string) ([]pokeapi.LocationAreaEncounter, error) {
+	return f.encounters, f.encountersErr
+}
+
+func TestVisitFunc(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		client  *fakeClient
+		wantErr bool
+	}{
+		{
+			name:    "no args",
+			args:    nil,
+			client:  &fakeClient{},
+			wantErr: true,
+		},
+		{
+			name:    "area lookup fails",
+			args:    []string{"pallet-town-area"},
+			client:  &fakeClient{locationAreaErr: errors.New("not found")},
+			wantErr: true,
+		},
+		{
+			name:    "valid area",
+			args:    []string{"pallet-town-area"},
+			client:  &fakeClient{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trainer := poketrainer.NewTrainer()
+			err := VisitFunc(tt.client, trainer)(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VisitFunc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && trainer.CurrentArea() != tt.args[0] {
+				t.Errorf("CurrentArea() = %q, want %q", trainer.CurrentArea(), tt.args[0])
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestMapAndMapbFuncPageBackAndForth(t *testing.T) {
+	page1 := pokeapi.NamedAPIResourceList{
+		Results:  []pokeapi.NamedAPIResource{{Name: "area-one"}},
+		Next:     strPtr("page2"),
+		Previous: nil,
+	}
+	page2 := pokeapi.NamedAPIResourceList{
+		Results:  []pokeapi.NamedAPIResource{{Name: "area-two"}},
+		Next:     nil,
+		Previous: strPtr("page1"),
+	}
+	client := &fakeClient{
+		locationAreaPages: map[string]pokeapi.NamedAPIResourceList{
+			"page1": page1,
+			"page2": page2,
+		},
+	}
+
+	pager := NewPager(client, "page1")
+
+	// mapb before any map call: no previous page yet.
+	if err := MapbFunc(pager)(nil); err == nil {
+		t.Fatal("MapbFunc() error = nil, want \"you're on the first page\"")
+	}
+
+	// map: fetches page1, cursor moves to page2.
+	if err := MapFunc(pager)(nil); err != nil {
+		t.Fatalf("MapFunc() error = %v", err)
+	}
+
+	// map: fetches page2, which has no further next page.
+	if err := MapFunc(pager)(nil); err != nil {
+		t.Fatalf("MapFunc() error = %v", err)
+	}
+
+	// map again: no next page left.
+	if err := MapFunc(pager)(nil); err == nil {
+		t.Fatal("MapFunc() error = nil, want \"you're on the last page\"")
+	}
+
+	// mapb: pages back from page2 to page1.
+	if err := MapbFunc(pager)(nil); err != nil {
+		t.Fatalf("MapbFunc() error = %v", err)
+	}
+
+	// map again: should be back on page2, not stuck or re-fetching page1.
+	if err := MapFunc(pager)(nil); err != nil {
+		t.Fatalf("MapFunc() error = %v", err)
+	}
+}
+
+func TestExploreFunc(t *testing.T) {
+	tests := []struct {
+		name    string
+		area    string
+		client  *fakeClient
+		wantErr bool
+	}{
+		{
+			name:    "no area visited",
+			area:    "",
+			client:  &fakeClient{},
+			wantErr: true,
+		},
+		{
+			name:    "area lookup fails",
+			area:    "pallet-town-area",
+			client:  &fakeClient{locationAreaErr: errors.New("not found")},
+			wantErr: true,
+		},
+		{
+			name:    "valid area",
+			area:    "pallet-town-area",
+			client:  &fakeClient{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trainer := poketrainer.NewTrainer()
+			if tt.area != "" {
+				trainer.Visit(tt.area)
+			}
+			err := ExploreFunc(tt.client, trainer)(nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExploreFunc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInspectFunc(t *testing.T) {
+	trainer := poketrainer.NewTrainer()
+	trainer.Catch("pikachu", pokeapi.Pokemon{Name: "pikachu"})
+
+	if err := InspectFunc(trainer)(nil); err == nil {
+		t.Fatal("InspectFunc() error = nil, want usage error for missing args")
+	}
+	if err := InspectFunc(trainer)([]string{"pikachu"}); err != nil {
+		t.Fatalf("InspectFunc() error = %v, want nil for a caught pokemon", err)
+	}
+	if err := InspectFunc(trainer)([]string{"charmander"}); err != nil {
+		t.Fatalf("InspectFunc() error = %v, want nil (just prints not-caught) for an uncaught pokemon", err)
+	}
+}
+
+func TestPokedexFunc(t *testing.T) {
+	trainer := poketrainer.NewTrainer()
+	if err := PokedexFunc(trainer)(nil); err != nil {
+		t.Fatalf("PokedexFunc() error = %v, want nil with an empty pokedex", err)
+	}
+
+	trainer.Catch("pikachu", pokeapi.Pokemon{Name: "pikachu"})
+	if err := PokedexFunc(trainer)(nil); err != nil {
+		t.Fatalf("PokedexFunc() error = %v, want nil with a caught pokemon", err)
+	}
+}
+
+func TestReleaseFunc(t *testing.T) {
+	t.Setenv("POKEDEX_HOME", t.TempDir())
+
+	trainer := poketrainer.NewTrainer()
+	trainer.Catch("pikachu", pokeapi.Pokemon{Name: "pikachu"})
+
+	if err := ReleaseFunc(trainer)(nil); err == nil {
+		t.Fatal("ReleaseFunc() error = nil, want usage error for missing args")
+	}
+
+	if err := ReleaseFunc(trainer)([]string{"pikachu"}); err != nil {
+		t.Fatalf("ReleaseFunc() error = %v", err)
+	}
+	if _, ok := trainer.Inspect("pikachu"); ok {
+		t.Error("expected pikachu to be released")
+	}
+
+	// Releasing again should report "not caught", not error.
+	if err := ReleaseFunc(trainer)([]string{"pikachu"}); err != nil {
+		t.Fatalf("ReleaseFunc() error = %v, want nil for an already-released pokemon", err)
+	}
+}
+
+func TestCatchFunc(t *testing.T) {
+	pikachu := pokeapi.Pokemon{Name: "pikachu", Experience: 0}
+
+	tests := []struct {
+		name    string
+		args    []string
+		client  *fakeClient
+		area    string
+		wantErr bool
+	}{
+		{
+			name:    "no args",
+			args:    nil,
+			client:  &fakeClient{},
+			area:    "pallet-town-area",
+			wantErr: true,
+		},
+		{
+			name:    "no area visited",
+			args:    []string{"pikachu"},
+			client:  &fakeClient{},
+			area:    "",
+			wantErr: true,
+		},
+		{
+			name: "pokemon not in area",
+			args: []string{"pikachu"},
+			client: &fakeClient{
+				pokemon: pikachu,
+				encounters: []pokeapi.LocationAreaEncounter{
+					{LocationArea: pokeapi.NamedAPIResource{Name: "viridian-forest-area"}},
+				},
+			},
+			area:    "pallet-town-area",
+			wantErr: true,
+		},
+		{
+			name: "pokemon in area",
+			args: []string{"pikachu"},
+			client: &fakeClient{
+				pokemon: pikachu,
+				encounters: []pokeapi.LocationAreaEncounter{
+					{LocationArea: pokeapi.NamedAPIResource{Name: "pallet-town-area"}},
+				},
+			},
+			area:    "pallet-town-area",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("POKEDEX_HOME", t.TempDir())
+			trainer := poketrainer.NewTrainer()
+			if tt.area != "" {
+				trainer.Visit(tt.area)
+			}
+			calculator := catch.NewCatchCalculator(catch.DebugAlwaysCatch{})
+			err := CatchFunc(tt.client, trainer, calculator)(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CatchFunc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// ExitFunc returns the "exit" command, saving the pokedex before quitting.
+func ExitFunc(trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if err := trainer.Save(); err != nil {
+			fmt.Println("failed to save pokedex:", err)
+		}
+		fmt.Printf("Closing the Pokedex... Goodbye!")
+		os.Exit(0)
+		return nil
+	}
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// ExploreFunc returns the "explore" command, which lists the Pokemon found
+// in the trainer's current location area.
+func ExploreFunc(client pokeAPIClient, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		area := trainer.CurrentArea()
+		if area == "" {
+			return fmt.Errorf("visit a location area first")
+		}
+
+		res, err := client.GetLocationArea(area)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Exploring %s...\n", area)
+		fmt.Println("Found Pokemon:")
+		for _, e := range res.PokemonEncounters {
+			fmt.Println(" -", e.Pokemon.Name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,23 @@
+package commands
+
+import "fmt"
+
+// HelpFunc returns the "help" command.
+func HelpFunc() CommandFunc {
+	return func(args []string) error {
+		fmt.Println(`Welcome to the Pokedex!
+Usage:
+
+help: Displays a help message
+exit: Exit the Pokedex
+map: Displays the next page of locations
+mapb: Displays the previous page of locations
+visit: Visit a location area
+explore: List the Pokemon found in the current location area
+catch: Catch a Pokemon
+release: Release a caught Pokemon
+inspect: Inspect a caught Pokemon
+pokedex: List all caught Pokemon`)
+		return nil
+	}
+}
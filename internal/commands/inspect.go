@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// InspectFunc returns the "inspect" command.
+func InspectFunc(trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("usage: inspect <pokemon-name>")
+		}
+		name := args[0]
+
+		pokemon, ok := trainer.Inspect(name)
+		if !ok {
+			fmt.Println("you have not caught that pokemon")
+			return nil
+		}
+		fmt.Printf("Name: %s\n", pokemon.Name)
+		fmt.Printf("Height: %d\n", pokemon.Height)
+		fmt.Printf("Weight: %d\n", pokemon.Weight)
+		fmt.Println("Stats:")
+		for _, s := range pokemon.Stats {
+			fmt.Printf("  -%s: %d\n", s.Stat.Name, s.BaseStat)
+		}
+		fmt.Println("Types:")
+		for _, t := range pokemon.Types {
+			fmt.Printf("  - %s\n", t.Type.Name)
+		}
+		return nil
+	}
+}
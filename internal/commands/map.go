@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Pager tracks the pagination cursor shared by the "map" and "mapb"
+// commands.
+type Pager struct {
+	client   pokeAPIClient
+	next     *string
+	previous *string
+}
+
+// NewPager returns a Pager that starts at firstURL.
+func NewPager(client pokeAPIClient, firstURL string) *Pager {
+	return &Pager{
+		client: client,
+		next:   &firstURL,
+	}
+}
+
+// fetch retrieves the location area page at url, prints it, and advances the
+// pagination cursors from the response. It's shared by MapFunc and MapbFunc,
+// which differ only in which cursor they read from.
+func (p *Pager) fetch(url string) error {
+	res, err := p.client.GetLocationAreaList(url)
+	if err != nil {
+		return err
+	}
+	for _, loc := range res.Results {
+		fmt.Println(loc.Name)
+	}
+
+	p.next = res.Next
+	p.previous = res.Previous
+	return nil
+}
+
+// MapFunc returns the "map" command, which displays the next page of
+// locations.
+func MapFunc(pager *Pager) CommandFunc {
+	return func(args []string) error {
+		if pager.next == nil {
+			return errors.New("you're on the last page")
+		}
+		return pager.fetch(*pager.next)
+	}
+}
+
+// MapbFunc returns the "mapb" command, which displays the previous page of
+// locations.
+func MapbFunc(pager *Pager) CommandFunc {
+	return func(args []string) error {
+		if pager.previous == nil {
+			return errors.New("you're on the first page")
+		}
+		return pager.fetch(*pager.previous)
+	}
+}
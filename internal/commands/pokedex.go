@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// PokedexFunc returns the "pokedex" command, which lists all caught Pokemon.
+func PokedexFunc(trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		names := trainer.CaughtNames()
+		if len(names) == 0 {
+			fmt.Println("you have not caught any pokemon yet")
+			return nil
+		}
+		fmt.Println("Your Pokedex:")
+		for _, name := range names {
+			fmt.Println(" -", name)
+		}
+		return nil
+	}
+}
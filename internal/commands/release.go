@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// ReleaseFunc returns the "release" command, which removes a Pokemon from
+// the pokedex and persists the change.
+func ReleaseFunc(trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("usage: release <pokemon-name>")
+		}
+		name := args[0]
+
+		if !trainer.Release(name) {
+			fmt.Println("you have not caught that pokemon")
+			return nil
+		}
+
+		if err := trainer.Save(); err != nil {
+			return fmt.Errorf("released %s but failed to save pokedex: %w", name, err)
+		}
+
+		fmt.Printf("%s was released\n", name)
+		return nil
+	}
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/ebrahim5801/pokedex/internal/poketrainer"
+)
+
+// VisitFunc returns the "visit" command, which sets the trainer's current
+// location area.
+func VisitFunc(client pokeAPIClient, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("usage: visit <location-area>")
+		}
+		area := args[0]
+
+		if _, err := client.GetLocationArea(area); err != nil {
+			return err
+		}
+
+		trainer.Visit(area)
+		fmt.Printf("You are now in %s\n", area)
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+// Package pokeapi holds the data types returned by the PokeAPI endpoints
+// that pokeclient talks to.
+package pokeapi
+
+// NamedAPIResourceList is the paginated response shape shared by most
+// PokeAPI list endpoints (e.g. location-area).
+type NamedAPIResourceList struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []NamedAPIResource `json:"results"`
+}
+
+// NamedAPIResource is a named, linked resource reference.
+type NamedAPIResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// LocationArea is a single location-area resource.
+type LocationArea struct {
+	Name              string             `json:"name"`
+	PokemonEncounters []PokemonEncounter `json:"pokemon_encounters"`
+}
+
+// PokemonEncounter describes a Pokemon that can be found in a LocationArea.
+type PokemonEncounter struct {
+	Pokemon NamedAPIResource `json:"pokemon"`
+}
+
+// Pokemon is a single pokemon resource.
+type Pokemon struct {
+	Name                   string        `json:"name"`
+	Experience             int           `json:"base_experience"`
+	Height                 int           `json:"height"`
+	Weight                 int           `json:"weight"`
+	Stats                  []PokemonStat `json:"stats"`
+	Types                  []PokemonType `json:"types"`
+	LocationAreaEncounters string        `json:"location_area_encounters"`
+}
+
+// PokemonStat is one base stat entry on a Pokemon.
+type PokemonStat struct {
+	BaseStat int      `json:"base_stat"`
+	Stat     StatName `json:"stat"`
+}
+
+// StatName names a PokemonStat.
+type StatName struct {
+	Name string `json:"name"`
+}
+
+// PokemonType is one of a Pokemon's elemental types.
+type PokemonType struct {
+	Type TypeName `json:"type"`
+}
+
+// TypeName names a PokemonType.
+type TypeName struct {
+	Name string `json:"name"`
+}
+
+// LocationAreaEncounter is an entry in a Pokemon's location-area-encounters
+// list, i.e. the areas a given Pokemon can be found in.
+type LocationAreaEncounter struct {
+	LocationArea NamedAPIResource `json:"location_area"`
+}
@@ -0,0 +1,71 @@
+// Package pokecache provides a simple in-memory cache with expiring entries,
+// used to avoid re-fetching the same PokeAPI resource repeatedly.
+package pokecache
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	createdAt time.Time
+	val       []byte
+}
+
+// Cache is a thread-safe, time-based cache. Entries older than the configured
+// interval are periodically reaped in the background.
+type Cache struct {
+	mu       sync.Mutex
+	entries  map[string]cacheEntry
+	interval time.Duration
+}
+
+// NewCache creates a Cache whose entries expire after interval and starts the
+// background reaping loop.
+func NewCache(interval time.Duration) *Cache {
+	c := &Cache{
+		entries:  make(map[string]cacheEntry),
+		interval: interval,
+	}
+	go c.reapLoop()
+	return c
+}
+
+// Add stores val under key, overwriting any existing entry.
+func (c *Cache) Add(key string, val []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{
+		createdAt: time.Now(),
+		val:       val,
+	}
+}
+
+// Get returns the value stored under key, if present.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return entry.val, true
+}
+
+func (c *Cache) reapLoop() {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.reap(time.Now(), c.interval)
+	}
+}
+
+func (c *Cache) reap(now time.Time, last time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if now.Sub(entry.createdAt) > last {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package pokeclient provides a typed HTTP client for the PokeAPI, backed by
+// a TTL cache so repeated lookups don't hit the network.
+package pokeclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ebrahim5801/pokedex/internal/pokeapi"
+	"github.com/ebrahim5801/pokedex/internal/pokecache"
+)
+
+const baseURL = "https://pokeapi.co/api/v2"
+
+// Client talks to the PokeAPI and caches responses for cacheTTL.
+type Client struct {
+	cache      *pokecache.Cache
+	httpClient http.Client
+}
+
+// NewClient builds a Client whose cache entries expire after cacheTTL and
+// whose HTTP requests time out after timeout.
+func NewClient(cacheTTL, timeout time.Duration) *Client {
+	return &Client{
+		cache: pokecache.NewCache(cacheTTL),
+		httpClient: http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// GetLocationAreaList fetches a page of location areas. url may be empty, in
+// which case the first page is requested.
+func (c *Client) GetLocationAreaList(url string) (pokeapi.NamedAPIResourceList, error) {
+	if url == "" {
+		url = baseURL + "/location-area"
+	}
+
+	var res pokeapi.NamedAPIResourceList
+	err := c.getOrFetch(url, &res)
+	return res, err
+}
+
+// GetLocationArea fetches a single location area by name.
+func (c *Client) GetLocationArea(name string) (pokeapi.LocationArea, error) {
+	url := baseURL + "/location-area/" + name
+
+	var res pokeapi.LocationArea
+	err := c.getOrFetch(url, &res)
+	return res, err
+}
+
+// GetPokemon fetches a single pokemon by name.
+func (c *Client) GetPokemon(name string) (pokeapi.Pokemon, error) {
+	url := baseURL + "/pokemon/" + name
+
+	var res pokeapi.Pokemon
+	err := c.getOrFetch(url, &res)
+	return res, err
+}
+
+// GetPokemonLocationAreas fetches the location areas a pokemon can be
+// encountered in.
+func (c *Client) GetPokemonLocationAreas(url string) ([]pokeapi.LocationAreaEncounter, error) {
+	var res []pokeapi.LocationAreaEncounter
+	err := c.getOrFetch(url, &res)
+	return res, err
+}
+
+// getOrFetch serves url from the cache if present, otherwise fetches it over
+// HTTP, caches the raw body, and unmarshals it into out.
+func (c *Client) getOrFetch(url string, out any) error {
+	if data, ok := c.cache.Get(url); ok {
+		return json.Unmarshal(data, out)
+	}
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return fmt.Errorf("error fetching %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("request to %s failed with status %d", url, resp.StatusCode)
+	}
+
+	c.cache.Add(url, body)
+
+	return json.Unmarshal(body, out)
+}
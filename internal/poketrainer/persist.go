@@ -0,0 +1,89 @@
+package poketrainer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const pokedexFileName = "pokedex.json"
+
+// home returns the directory the pokedex is persisted under: POKEDEX_HOME if
+// set, otherwise ~/.config/pokedex.
+func home() (string, error) {
+	if dir := os.Getenv("POKEDEX_HOME"); dir != "" {
+		return dir, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".config", "pokedex"), nil
+}
+
+func path() (string, error) {
+	dir, err := home()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, pokedexFileName), nil
+}
+
+// Load reads the persisted pokedex from disk, if it exists, merging it into
+// the trainer's in-memory state. If it exists but can't be read or parsed,
+// Load reports the error and marks the trainer so that Save refuses to run
+// until the problem is fixed, rather than silently overwriting a pokedex we
+// failed to load with a near-empty one.
+func (t *Trainer) Load() error {
+	file, err := path()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.loadErr = err
+		return err
+	}
+
+	if err := json.Unmarshal(data, &t.pokedex); err != nil {
+		t.loadErr = err
+		return err
+	}
+	return nil
+}
+
+// Save atomically writes the trainer's pokedex to disk. It refuses to run if
+// a prior Load failed to read or parse an existing pokedex file, to avoid
+// overwriting data that might still be recoverable.
+func (t *Trainer) Save() error {
+	if t.loadErr != nil {
+		return fmt.Errorf("refusing to save: the existing pokedex failed to load (%w); fix or remove it first", t.loadErr)
+	}
+
+	file, err := path()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(t.pokedex, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+
+	tmpFile := file + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, file)
+}
@@ -0,0 +1,114 @@
+package poketrainer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ebrahim5801/pokedex/internal/pokeapi"
+)
+
+func TestLoadWithNoFileYet(t *testing.T) {
+	t.Setenv("POKEDEX_HOME", t.TempDir())
+
+	trainer := NewTrainer()
+	if err := trainer.Load(); err != nil {
+		t.Fatalf("Load() error = %v, want nil", err)
+	}
+	if len(trainer.CaughtNames()) != 0 {
+		t.Errorf("CaughtNames() = %v, want empty", trainer.CaughtNames())
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("POKEDEX_HOME", home)
+
+	trainer := NewTrainer()
+	trainer.Catch("pikachu", pokeapi.Pokemon{Name: "pikachu", Experience: 42})
+	if err := trainer.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	file := filepath.Join(home, pokedexFileName)
+	if _, err := os.Stat(file); err != nil {
+		t.Fatalf("expected %s to exist: %v", file, err)
+	}
+	if _, err := os.Stat(file + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("expected %s.tmp to be gone after rename, stat err = %v", file, err)
+	}
+
+	reloaded := NewTrainer()
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	pokemon, ok := reloaded.Inspect("pikachu")
+	if !ok {
+		t.Fatal("expected pikachu to survive a save/load round trip")
+	}
+	if pokemon.Experience != 42 {
+		t.Errorf("Experience = %d, want 42", pokemon.Experience)
+	}
+}
+
+func TestSaveRefusesAfterCorruptLoad(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("POKEDEX_HOME", home)
+
+	file := filepath.Join(home, pokedexFileName)
+	if err := os.MkdirAll(home, 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(file, []byte("not valid json"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	original, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	trainer := NewTrainer()
+	if err := trainer.Load(); err == nil {
+		t.Fatal("Load() error = nil, want an error for corrupt JSON")
+	}
+
+	trainer.Catch("pikachu", pokeapi.Pokemon{Name: "pikachu"})
+	if err := trainer.Save(); err == nil {
+		t.Fatal("Save() error = nil, want it to refuse to run after a failed Load")
+	}
+
+	after, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if string(after) != string(original) {
+		t.Error("Save() overwrote the existing pokedex file despite a failed Load")
+	}
+}
+
+func TestReleaseThenSaveRemovesEntry(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("POKEDEX_HOME", home)
+
+	trainer := NewTrainer()
+	trainer.Catch("pikachu", pokeapi.Pokemon{Name: "pikachu"})
+	if err := trainer.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if !trainer.Release("pikachu") {
+		t.Fatal("Release() = false, want true")
+	}
+	if err := trainer.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reloaded := NewTrainer()
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, ok := reloaded.Inspect("pikachu"); ok {
+		t.Error("expected pikachu to be gone after release + save")
+	}
+}
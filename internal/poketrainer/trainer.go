@@ -0,0 +1,65 @@
+// Package poketrainer models the trainer's state: the Pokemon they've caught
+// (persisted to disk by Save and Load) and the location area they're
+// currently exploring (kept in memory only).
+package poketrainer
+
+import (
+	"sort"
+
+	"github.com/ebrahim5801/pokedex/internal/pokeapi"
+)
+
+// Trainer tracks a player's caught Pokemon and current location area.
+type Trainer struct {
+	pokedex     map[string]pokeapi.Pokemon
+	currentArea string
+	loadErr     error
+}
+
+// NewTrainer returns an empty Trainer with no current area.
+func NewTrainer() *Trainer {
+	return &Trainer{
+		pokedex: map[string]pokeapi.Pokemon{},
+	}
+}
+
+// Visit sets the trainer's current location area.
+func (t *Trainer) Visit(area string) {
+	t.currentArea = area
+}
+
+// CurrentArea returns the location area the trainer is currently exploring,
+// or "" if they haven't visited one yet.
+func (t *Trainer) CurrentArea() string {
+	return t.currentArea
+}
+
+// Catch adds pokemon to the pokedex under name.
+func (t *Trainer) Catch(name string, pokemon pokeapi.Pokemon) {
+	t.pokedex[name] = pokemon
+}
+
+// Inspect returns the caught Pokemon named name, if any.
+func (t *Trainer) Inspect(name string) (pokeapi.Pokemon, bool) {
+	pokemon, ok := t.pokedex[name]
+	return pokemon, ok
+}
+
+// Release removes name from the pokedex, reporting whether it was present.
+func (t *Trainer) Release(name string) bool {
+	if _, ok := t.pokedex[name]; !ok {
+		return false
+	}
+	delete(t.pokedex, name)
+	return true
+}
+
+// CaughtNames returns the names of every Pokemon the trainer has caught.
+func (t *Trainer) CaughtNames() []string {
+	names := make([]string, 0, len(t.pokedex))
+	for name := range t.pokedex {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}